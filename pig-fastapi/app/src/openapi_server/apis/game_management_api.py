@@ -1,6 +1,6 @@
 # coding: utf-8
 
-from typing import Dict, List  # noqa: F401
+from typing import Dict, List, Optional  # noqa: F401
 import importlib
 import pkgutil
 
@@ -49,10 +49,12 @@ for _, name, _ in pkgutil.iter_modules(ns_pkg.__path__, ns_pkg.__name__ + "."):
     response_model_by_alias=True,
 )
 async def create_new_game(
+    max_turn_score: Annotated[Optional[Annotated[int, Field(ge=1)]], Field(description="Cap on a single turn's score; reaching it forces a hold. Omit to use the server default (PIG_MAX_TURN_SCORE, uncapped if unset).")] = Query(None, description="Cap on a single turn's score; reaching it forces a hold. Omit to use the server default (PIG_MAX_TURN_SCORE, uncapped if unset).", alias="max_turn_score", ge=1),
+    free_first_turn: Annotated[Optional[bool], Field(description="Treat a 1 on each player's first turn as a free re-roll. Omit to use the server default (PIG_FREE_FIRST_TURN, off if unset).")] = Query(None, description="Treat a 1 on each player's first turn as a free re-roll. Omit to use the server default (PIG_FREE_FIRST_TURN, off if unset).", alias="free_first_turn"),
 ) -> NewGameResponse:
     if not BaseGameManagementApi.subclasses:
         raise HTTPException(status_code=500, detail="Not implemented")
-    return await BaseGameManagementApi.subclasses[0]().create_new_game()
+    return await BaseGameManagementApi.subclasses[0]().create_new_game(max_turn_score, free_first_turn)
 
 
 @router.get(
@@ -68,7 +70,28 @@ async def create_new_game(
 )
 async def get_game_state(
     game_id: Annotated[UUID, Field(description="The unique identifier of the game.")] = Path(..., description="The unique identifier of the game."),
+    include_history: Annotated[Optional[bool], Field(description="Include each player's completed turns in the returned state.")] = Query(False, description="Include each player's completed turns in the returned state.", alias="include_history"),
 ) -> GameState:
     if not BaseGameManagementApi.subclasses:
         raise HTTPException(status_code=500, detail="Not implemented")
-    return await BaseGameManagementApi.subclasses[0]().get_game_state(game_id)
+    return await BaseGameManagementApi.subclasses[0]().get_game_state(game_id, include_history)
+
+
+@router.post(
+    "/game/{game_id}/start",
+    responses={
+        200: {"model": GameState, "description": "Game state after starting."},
+        400: {"model": ErrorResponse, "description": "Invalid game state (e.g., waiting for second player, game already started)."},
+        404: {"model": ErrorResponse, "description": "Game not found."},
+        500: {"model": ErrorResponse, "description": "Internal server error."},
+    },
+    tags=["Game Management"],
+    summary="Start a game once both players have joined.",
+    response_model_by_alias=True,
+)
+async def start_game(
+    game_id: Annotated[UUID, Field(description="The unique identifier of the game.")] = Path(..., description="The unique identifier of the game."),
+) -> GameState:
+    if not BaseGameManagementApi.subclasses:
+        raise HTTPException(status_code=500, detail="Not implemented")
+    return await BaseGameManagementApi.subclasses[0]().start_game(game_id)
@@ -1,6 +1,6 @@
 # coding: utf-8
 
-from typing import ClassVar, Dict, List, Tuple  # noqa: F401
+from typing import ClassVar, Dict, List, Optional, Tuple  # noqa: F401
 
 from pydantic import Field
 from typing_extensions import Annotated
@@ -18,6 +18,8 @@ class BaseGameManagementApi:
         BaseGameManagementApi.subclasses = BaseGameManagementApi.subclasses + (cls,)
     async def create_new_game(
         self,
+        max_turn_score: Annotated[Optional[Annotated[int, Field(ge=1)]], Field(description="Cap on a single turn's score; reaching it forces a hold. Omit to use the server default (PIG_MAX_TURN_SCORE, uncapped if unset).")],
+        free_first_turn: Annotated[Optional[bool], Field(description="Treat a 1 on each player's first turn as a free re-roll. Omit to use the server default (PIG_FREE_FIRST_TURN, off if unset).")],
     ) -> NewGameResponse:
         ...
 
@@ -25,5 +27,13 @@ class BaseGameManagementApi:
     async def get_game_state(
         self,
         game_id: Annotated[UUID, Field(description="The unique identifier of the game.")],
+        include_history: Annotated[Optional[bool], Field(description="Include each player's completed turns in the returned state.")],
+    ) -> GameState:
+        ...
+
+
+    async def start_game(
+        self,
+        game_id: Annotated[UUID, Field(description="The unique identifier of the game.")],
     ) -> GameState:
         ...
@@ -1,6 +1,6 @@
 # coding: utf-8
 
-from typing import Dict, List  # noqa: F401
+from typing import Dict, List, Optional  # noqa: F401
 import importlib
 import pkgutil
 
@@ -28,6 +28,7 @@ from typing_extensions import Annotated
 from uuid import UUID
 from openapi_server.models.error_response import ErrorResponse
 from openapi_server.models.game_state import GameState
+from openapi_server.models.move_options import MoveOptions
 
 
 router = APIRouter()
@@ -37,6 +38,26 @@ for _, name, _ in pkgutil.iter_modules(ns_pkg.__path__, ns_pkg.__name__ + "."):
     importlib.import_module(name)
 
 
+@router.get(
+    "/game/{game_id}/options",
+    responses={
+        200: {"model": MoveOptions, "description": "Actions available to the player."},
+        404: {"model": ErrorResponse, "description": "Game not found."},
+        500: {"model": ErrorResponse, "description": "Internal server error."},
+    },
+    tags=["Gameplay"],
+    summary="Get the actions a player may take right now.",
+    response_model_by_alias=True,
+)
+async def get_move_options(
+    game_id: Annotated[UUID, Field(description="The unique identifier of the game.")] = Path(..., description="The unique identifier of the game."),
+    player_id: Annotated[int, Field(le=1, ge=0, description="The player asking (0 or 1).")] = Query(..., description="The player asking (0 or 1).", alias="player_id", ge=0, le=1),
+) -> MoveOptions:
+    if not BaseGameplayApi.subclasses:
+        raise HTTPException(status_code=500, detail="Not implemented")
+    return await BaseGameplayApi.subclasses[0]().get_move_options(game_id, player_id)
+
+
 @router.post(
     "/game/{game_id}/roll",
     responses={
@@ -51,10 +72,11 @@ for _, name, _ in pkgutil.iter_modules(ns_pkg.__path__, ns_pkg.__name__ + "."):
 )
 async def roll_die(
     game_id: Annotated[UUID, Field(description="The unique identifier of the game.")] = Path(..., description="The unique identifier of the game."),
+    action_id: Annotated[Optional[Annotated[str, Field(strict=True, max_length=64)]], Field(description="Client-generated ID for this action. Retrying with the same ID returns the original result instead of acting twice.")] = Query(None, description="Client-generated ID for this action. Retrying with the same ID returns the original result instead of acting twice.", alias="action_id", max_length=64),
 ) -> GameState:
     if not BaseGameplayApi.subclasses:
         raise HTTPException(status_code=500, detail="Not implemented")
-    return await BaseGameplayApi.subclasses[0]().roll_die(game_id)
+    return await BaseGameplayApi.subclasses[0]().roll_die(game_id, action_id)
 
 
 @router.post(
@@ -71,7 +93,8 @@ async def roll_die(
 )
 async def hold_turn(
     game_id: Annotated[UUID, Field(description="The unique identifier of the game.")] = Path(..., description="The unique identifier of the game."),
+    action_id: Annotated[Optional[Annotated[str, Field(strict=True, max_length=64)]], Field(description="Client-generated ID for this action. Retrying with the same ID returns the original result instead of acting twice.")] = Query(None, description="Client-generated ID for this action. Retrying with the same ID returns the original result instead of acting twice.", alias="action_id", max_length=64),
 ) -> GameState:
     if not BaseGameplayApi.subclasses:
         raise HTTPException(status_code=500, detail="Not implemented")
-    return await BaseGameplayApi.subclasses[0]().hold_turn(game_id)
+    return await BaseGameplayApi.subclasses[0]().hold_turn(game_id, action_id)
@@ -1,12 +1,13 @@
 # coding: utf-8
 
-from typing import ClassVar, Dict, List, Tuple  # noqa: F401
+from typing import ClassVar, Dict, List, Optional, Tuple  # noqa: F401
 
 from pydantic import Field
 from typing_extensions import Annotated
 from uuid import UUID
 from openapi_server.models.error_response import ErrorResponse
 from openapi_server.models.game_state import GameState
+from openapi_server.models.move_options import MoveOptions
 
 
 class BaseGameplayApi:
@@ -15,9 +16,18 @@ class BaseGameplayApi:
     def __init_subclass__(cls, **kwargs):
         super().__init_subclass__(**kwargs)
         BaseGameplayApi.subclasses = BaseGameplayApi.subclasses + (cls,)
+    async def get_move_options(
+        self,
+        game_id: Annotated[UUID, Field(description="The unique identifier of the game.")],
+        player_id: Annotated[int, Field(le=1, ge=0, description="The player asking (0 or 1).")],
+    ) -> MoveOptions:
+        ...
+
+
     async def roll_die(
         self,
         game_id: Annotated[UUID, Field(description="The unique identifier of the game.")],
+        action_id: Annotated[Optional[Annotated[str, Field(strict=True, max_length=64)]], Field(description="Client-generated ID for this action. Retrying with the same ID returns the original result instead of acting twice.")],
     ) -> GameState:
         ...
 
@@ -25,5 +35,6 @@ class BaseGameplayApi:
     async def hold_turn(
         self,
         game_id: Annotated[UUID, Field(description="The unique identifier of the game.")],
+        action_id: Annotated[Optional[Annotated[str, Field(strict=True, max_length=64)]], Field(description="Client-generated ID for this action. Retrying with the same ID returns the original result instead of acting twice.")],
     ) -> GameState:
         ...
@@ -5,17 +5,24 @@ Implementation of the Pig Game API endpoints.
 This module contains the actual game logic for the Pig dice game with player matchmaking.
 """
 
+import logging
+import os
 import random
-from typing import Dict
+import time
+from collections import Counter
+from typing import Dict, List, Optional, Tuple
 from uuid import UUID, uuid4
 
-from fastapi import HTTPException
-from pydantic import BaseModel
+from fastapi import HTTPException, Request
+from pydantic import BaseModel, Field
 
 from openapi_server.apis.game_management_api_base import BaseGameManagementApi
 from openapi_server.apis.gameplay_api_base import BaseGameplayApi
+from openapi_server.models.game_rules import GameRules
 from openapi_server.models.game_state import GameState
+from openapi_server.models.move_options import MoveOptions
 from openapi_server.models.new_game_response import NewGameResponse
+from openapi_server.models.turn_record import TurnRecord
 
 
 # Game metadata to track player count
@@ -23,36 +30,346 @@ class GameMetadata(BaseModel):
     """Metadata about a game including its state and player count"""
     state: GameState
     player_count: int  # Number of players who have joined (1 or 2)
+    turn_history: List[TurnRecord] = []  # Completed turns, in play order
+    current_rolls: List[int] = []  # Rolls made so far in the current turn
+    # (action, action ID) -> state it produced, oldest first
+    recent_actions: Dict[Tuple[str, str], GameState] = {}
+    finished_at: Optional[float] = None  # time.monotonic() when the game ended
+    # time.monotonic() of the last join or move
+    last_action_at: float = Field(default_factory=time.monotonic)
 
 
 # In-memory storage for games (in production, use a database)
 game_storage: Dict[UUID, GameMetadata] = {}
 
+
+def env_int(name: str, default: Optional[int], minimum: int) -> Optional[int]:
+    """
+    Reads an integer setting from the environment, or returns default if unset.
+    
+    Raises:
+        RuntimeError: if the value is not an integer of at least minimum, so a
+            bad setting stops the server at startup rather than failing requests
+    """
+    value = os.environ.get(name, "").strip()
+    if not value:
+        return default
+    
+    try:
+        number = int(value)
+    except ValueError:
+        raise RuntimeError(f"{name} must be an integer, got {value!r}") from None
+    
+    if number < minimum:
+        raise RuntimeError(f"{name} must be at least {minimum}, got {number}")
+    
+    return number
+
+
 # Game configuration
 WINNING_SCORE = 100
 
+# Default for the optional turn score cap house rule, used when a new game doesn't
+# choose one: a turn total reaching this value forces a hold (None disables it)
+DEFAULT_MAX_TURN_SCORE: Optional[int] = env_int("PIG_MAX_TURN_SCORE", None, 1)
+
+# Default for the optional "Free Pig" rule: a 1 on a player's very first turn is a
+# re-roll instead of a pig-out
+DEFAULT_FREE_FIRST_TURN = (
+    os.environ.get("PIG_FREE_FIRST_TURN", "").lower() in ("1", "true", "yes")
+)
+
+# Unversioned actions that start a full game implicitly, for clients that predate /start
+LEGACY_IMPLICIT_START_ACTIONS = ("roll", "hold")
+
+# Number of recent action IDs remembered per game for deduplicating retries
+ACTION_ID_WINDOW = 32
+
+# Cleanup policies, in seconds, applied each time a new game is requested.
+# Finished games are kept this long so players can still view the result.
+GAME_OVER_RETENTION_SECONDS = env_int("PIG_GAME_OVER_RETENTION_SECONDS", 10 * 60, 0)
+# Games that never got a second player
+EMPTY_LOBBY_TTL_SECONDS = env_int("PIG_EMPTY_LOBBY_TTL_SECONDS", 30 * 60, 1)
+# Games in progress with no moves
+ABANDONED_GAME_TTL_SECONDS = env_int("PIG_ABANDONED_GAME_TTL_SECONDS", 30 * 60, 1)
+
+# Running totals of removed games by policy ("finished", "empty_lobby",
+# "abandoned"), reported by GET /api/metrics
+reaped_games: Counter = Counter()
+
+logger = logging.getLogger(__name__)
+
+
+def start_error(state: GameState) -> Optional[str]:
+    """
+    Returns the reason the game cannot be started, or None if it can.
+    """
+    # Both players must be present before the game can start
+    if not state.ready_to_start:
+        return "Cannot start game. Waiting for second player to join."
+    
+    if state.started:
+        return "Game has already started"
+    
+    return None
+
+
+def roll_error(state: GameState) -> Optional[str]:
+    """
+    Returns the reason the current player cannot roll, or None if they can.
+    """
+    # Check if game is ready to start
+    if not state.ready_to_start:
+        return "Cannot start game. Waiting for second player to join."
+    
+    # Check if game has been started
+    if not state.started:
+        return "Game has not been started yet."
+    
+    # Check if game is already over
+    if state.is_game_over:
+        return "Game is already over"
+    
+    return None
+
+
+def hold_error(state: GameState, turn_rolls: List[int]) -> Optional[str]:
+    """
+    Returns the reason the current player cannot hold, or None if they can.
+    
+    turn_rolls are the rolls made so far in the current turn.
+    """
+    error = roll_error(state)
+    if error:
+        return error
+    
+    # Cannot hold if last roll was a 1 that ended the turn. A free re-roll
+    # under the free first turn rule stays in the turn's rolls because the
+    # turn goes on.
+    if state.last_roll == 1 and not turn_rolls:
+        return "Cannot hold after rolling a 1. Turn has already ended."
+    
+    # Cannot hold if turn_total is 0 (must roll at least once)
+    if state.turn_total == 0:
+        return "Cannot hold with zero points. You must roll at least once."
+    
+    return None
+
+
+def remember_action(
+    game_metadata: GameMetadata, action: str, action_id: Optional[str], state: GameState
+) -> None:
+    """
+    Records the state produced by a client action so a retry of it is not applied twice.
+    
+    Entries are keyed by action ("roll" or "hold") as well as ID, so an ID
+    reused for a different action is not mistaken for a retry.
+    """
+    if action_id is None:
+        return
+    
+    game_metadata.recent_actions[(action, action_id)] = state
+    
+    # Forget the oldest actions once the window is full
+    while len(game_metadata.recent_actions) > ACTION_ID_WINDOW:
+        del game_metadata.recent_actions[next(iter(game_metadata.recent_actions))]
+
+
+def expiry_reason(metadata: GameMetadata, now: float) -> Optional[str]:
+    """
+    Returns the cleanup policy a game has expired under, or None if it should be kept.
+    """
+    if metadata.finished_at is not None:
+        if now - metadata.finished_at >= GAME_OVER_RETENTION_SECONDS:
+            return "finished"
+        return None
+    
+    idle = now - metadata.last_action_at
+    
+    # Still waiting for a second player
+    if metadata.player_count == 1:
+        return "empty_lobby" if idle >= EMPTY_LOBBY_TTL_SECONDS else None
+    
+    # Both players joined but nobody is moving
+    return "abandoned" if idle >= ABANDONED_GAME_TTL_SECONDS else None
+
+
+def purge_expired_games() -> None:
+    """
+    Removes games that have expired under one of the cleanup policies.
+    """
+    now = time.monotonic()
+    reaped = Counter()
+    for game_id, metadata in list(game_storage.items()):
+        reason = expiry_reason(metadata, now)
+        if reason is not None:
+            del game_storage[game_id]
+            reaped[reason] += 1
+    
+    if reaped:
+        reaped_games.update(reaped)
+        logger.info(
+            "Removed expired games: %s (totals: %s)", dict(reaped), dict(reaped_games)
+        )
+
+
+def is_first_turn(game_metadata: GameMetadata) -> bool:
+    """
+    Returns True if the current player has not completed a turn yet.
+    """
+    player = game_metadata.state.current_player_index
+    return all(turn.player_index != player for turn in game_metadata.turn_history)
+
+
+def bank_turn(
+    game_metadata: GameMetadata, points: int, last_roll: Optional[int] = None
+) -> GameState:
+    """
+    Ends the current player's turn by adding points to their score.
+    
+    Records the turn, then either ends the game if the player reached the
+    game's winning score or passes play to the other player. last_roll is carried
+    onto the new state (None for a normal hold).
+    """
+    old_state = game_metadata.state
+    
+    # Add points to current player's score
+    current_player = old_state.current_player_index
+    new_scores = old_state.scores.copy()
+    new_scores[current_player] += points
+    
+    # Record the completed turn
+    game_metadata.turn_history = game_metadata.turn_history + [TurnRecord(
+        player_index=current_player,
+        rolls=game_metadata.current_rolls,
+        points_banked=points,
+        pigged_out=False,
+        free_rerolls=game_metadata.current_rolls.count(1)
+    )]
+    game_metadata.current_rolls = []
+    
+    # Check if current player won
+    if new_scores[current_player] >= old_state.rules.winning_score:
+        game_metadata.finished_at = time.monotonic()
+        new_state = GameState(
+            game_id=old_state.game_id,
+            current_player_index=current_player,
+            scores=new_scores,
+            turn_total=0,
+            last_roll=last_roll,
+            ready_to_start=old_state.ready_to_start,
+            started=old_state.started,
+            rules=old_state.rules,
+            is_game_over=True,
+            winner_player_index=current_player
+        )
+    else:
+        # Switch to next player
+        new_state = GameState(
+            game_id=old_state.game_id,
+            current_player_index=1 - current_player,
+            scores=new_scores,
+            turn_total=0,
+            last_roll=last_roll,
+            ready_to_start=old_state.ready_to_start,
+            started=old_state.started,
+            rules=old_state.rules,
+            is_game_over=old_state.is_game_over,
+            winner_player_index=old_state.winner_player_index
+        )
+    
+    return new_state
+
+
+def mark_started(old_state: GameState) -> GameState:
+    """
+    Returns a copy of the state with started set.
+    """
+    return GameState(
+        game_id=old_state.game_id,
+        current_player_index=old_state.current_player_index,
+        scores=old_state.scores.copy(),
+        turn_total=old_state.turn_total,
+        last_roll=old_state.last_roll,
+        ready_to_start=old_state.ready_to_start,
+        started=True,
+        rules=old_state.rules,
+        is_game_over=old_state.is_game_over,
+        winner_player_index=old_state.winner_player_index
+    )
+
+
+async def start_for_legacy_clients(request: Request) -> None:
+    """
+    Starts a full game implicitly when a client on the unversioned aliases
+    rolls or holds, matching how the API behaved before POST /game/{game_id}/start.
+    Other requests, including reads and /start itself, leave the game as it is.
+    """
+    if request.url.path.rsplit("/", 1)[-1] not in LEGACY_IMPLICIT_START_ACTIONS:
+        return
+    
+    try:
+        game_id = UUID(request.path_params.get("game_id", ""))
+    except ValueError:
+        return
+    
+    game_metadata = game_storage.get(game_id)
+    if game_metadata is None or start_error(game_metadata.state):
+        return
+    
+    game_metadata.state = mark_started(game_metadata.state)
+    game_metadata.last_action_at = time.monotonic()
+
 
 class GameManagementApiImpl(BaseGameManagementApi):
     """
     Implementation of game management operations with player matchmaking.
     """
 
-    async def create_new_game(self) -> NewGameResponse:
+    async def create_new_game(
+        self,
+        max_turn_score: Optional[int] = None,
+        free_first_turn: Optional[bool] = None,
+    ) -> NewGameResponse:
         """
         Creates a new Pig game or joins an existing game waiting for a second player.
         
         Matchmaking logic:
-        1. Look for any existing game with only 1 player
+        1. Look for any existing game with only 1 player and the same rules
         2. If found, add this caller as player 1 (index 1)
         3. If not found, create a new game with this caller as player 0 (index 0)
         
+        Games that have expired under the cleanup policies (finished, empty
+        lobby, abandoned) are removed first, so storage does not grow without bound.
+        
+        Args:
+            max_turn_score: Turn score cap (None uses DEFAULT_MAX_TURN_SCORE)
+            free_first_turn: Whether the Free Pig rule applies (None uses
+                DEFAULT_FREE_FIRST_TURN)
+        
         Returns:
             NewGameResponse with game_id and player_id (0 or 1)
         """
-        # Look for a game waiting for a second player
+        purge_expired_games()
+        
+        if max_turn_score is None:
+            max_turn_score = DEFAULT_MAX_TURN_SCORE
+        if free_first_turn is None:
+            free_first_turn = DEFAULT_FREE_FIRST_TURN
+        rules = GameRules(
+            winning_score=WINNING_SCORE,
+            max_turn_score=max_turn_score,
+            free_first_turn=free_first_turn
+        )
+        
+        # Look for a game waiting for a second player with the same rules
         waiting_game_id = None
         for game_id, metadata in game_storage.items():
-            if metadata.player_count == 1 and not metadata.state.is_game_over:
+            if (
+                metadata.player_count == 1
+                and not metadata.state.is_game_over
+                and metadata.state.rules == rules
+            ):
                 waiting_game_id = game_id
                 break
         
@@ -69,12 +386,15 @@ class GameManagementApiImpl(BaseGameManagementApi):
                 turn_total=old_state.turn_total,
                 last_roll=old_state.last_roll,
                 ready_to_start=True,  # Now we have 2 players
+                started=old_state.started,
+                rules=old_state.rules,
                 is_game_over=old_state.is_game_over,
                 winner_player_index=old_state.winner_player_index
             )
             
             game_metadata.state = new_state
             game_metadata.player_count = 2
+            game_metadata.last_action_at = time.monotonic()
             game_storage[waiting_game_id] = game_metadata
             
             # Return with player_id = 1
@@ -95,6 +415,8 @@ class GameManagementApiImpl(BaseGameManagementApi):
                 turn_total=0,  # No points accumulated yet
                 last_roll=None,  # No roll yet
                 ready_to_start=False,  # Waiting for player 1 to join
+                started=False,  # Player 0 starts the game once player 1 joins
+                rules=rules,
                 is_game_over=False,
                 winner_player_index=None
             )
@@ -102,7 +424,8 @@ class GameManagementApiImpl(BaseGameManagementApi):
             # Store the game with metadata
             game_metadata = GameMetadata(
                 state=game_state,
-                player_count=1  # Only player 0 has joined so far
+                player_count=1,  # Only player 0 has joined so far
+                last_action_at=time.monotonic()
             )
             game_storage[game_id] = game_metadata
             
@@ -112,12 +435,15 @@ class GameManagementApiImpl(BaseGameManagementApi):
                 player_id=0
             )
 
-    async def get_game_state(self, game_id: UUID) -> GameState:
+    async def get_game_state(
+        self, game_id: UUID, include_history: bool = False
+    ) -> GameState:
         """
         Retrieves the current state of a game.
         
         Args:
             game_id: The unique identifier of the game
+            include_history: Whether to attach each player's completed turns
             
         Returns:
             GameState object with current game information
@@ -128,7 +454,49 @@ class GameManagementApiImpl(BaseGameManagementApi):
         if game_id not in game_storage:
             raise HTTPException(status_code=404, detail=f"Game {game_id} not found")
         
-        return game_storage[game_id].state
+        game_metadata = game_storage[game_id]
+        
+        # History is kept on the metadata and only copied onto the state when asked for
+        if include_history:
+            return game_metadata.state.model_copy(
+                update={"turn_history": list(game_metadata.turn_history)}
+            )
+        
+        return game_metadata.state
+
+    async def start_game(self, game_id: UUID) -> GameState:
+        """
+        Starts a game once both players have joined.
+        
+        Rolling and holding are rejected until this has been called, so
+        joining a game no longer starts it implicitly (except for clients on
+        the unversioned aliases, see start_for_legacy_clients).
+        
+        Args:
+            game_id: The unique identifier of the game
+            
+        Returns:
+            Updated GameState with started set
+            
+        Raises:
+            HTTPException: 404 if game not found, 400 if invalid game state
+        """
+        if game_id not in game_storage:
+            raise HTTPException(status_code=404, detail=f"Game {game_id} not found")
+        
+        game_metadata = game_storage[game_id]
+        
+        error = start_error(game_metadata.state)
+        if error:
+            raise HTTPException(status_code=400, detail=error)
+        
+        new_state = mark_started(game_metadata.state)
+        
+        game_metadata.state = new_state
+        game_metadata.last_action_at = time.monotonic()
+        game_storage[game_id] = game_metadata
+        
+        return new_state
 
 
 class GameplayApiImpl(BaseGameplayApi):
@@ -136,7 +504,49 @@ class GameplayApiImpl(BaseGameplayApi):
     Implementation of gameplay operations (roll, hold).
     """
 
-    async def roll_die(self, game_id: UUID) -> GameState:
+    async def get_move_options(self, game_id: UUID, player_id: int) -> MoveOptions:
+        """
+        Reports which actions a player may take right now.
+        
+        Uses the same checks as start_game, roll_die and hold_turn, so clients
+        can enable or disable their buttons without duplicating the rules.
+        
+        Args:
+            game_id: The unique identifier of the game
+            player_id: The player asking (0 or 1)
+            
+        Returns:
+            MoveOptions for that player
+            
+        Raises:
+            HTTPException: 404 if game not found
+        """
+        if game_id not in game_storage:
+            raise HTTPException(status_code=404, detail=f"Game {game_id} not found")
+        
+        game_metadata = game_storage[game_id]
+        state = game_metadata.state
+        
+        # Nobody has a turn before the game starts or once it is over
+        is_your_turn = (
+            state.started
+            and not state.is_game_over
+            and state.current_player_index == player_id
+        )
+        
+        return MoveOptions(
+            is_your_turn=is_your_turn,
+            can_roll=is_your_turn and roll_error(state) is None,
+            can_hold=(
+                is_your_turn
+                and hold_error(state, game_metadata.current_rolls) is None
+            ),
+            can_start=start_error(state) is None
+        )
+
+    async def roll_die(
+        self, game_id: UUID, action_id: Optional[str] = None
+    ) -> GameState:
         """
         Rolls the die for the current player.
         
@@ -144,9 +554,14 @@ class GameplayApiImpl(BaseGameplayApi):
         - Roll a die (1-6)
         - If you roll a 1: lose all turn points, turn ends automatically
         - If you roll 2-6: add to turn total, can roll again or hold
+        - If the game has a turn score cap and the turn total reaches it: hold
+          automatically
+        - If the game uses the free first turn rule: a 1 on a player's first turn
+          is a re-roll
         
         Args:
             game_id: The unique identifier of the game
+            action_id: Optional client-generated ID used to deduplicate retries
             
         Returns:
             Updated GameState after the roll
@@ -161,23 +576,45 @@ class GameplayApiImpl(BaseGameplayApi):
         game_metadata = game_storage[game_id]
         old_state = game_metadata.state
         
-        # Check if game is ready to start
-        if not old_state.ready_to_start:
-            raise HTTPException(
-                status_code=400, 
-                detail="Cannot start game. Waiting for second player to join."
-            )
+        # A retried action returns the state it already produced
+        recent_actions = game_metadata.recent_actions
+        if action_id is not None and ("roll", action_id) in recent_actions:
+            return recent_actions[("roll", action_id)]
         
-        # Check if game is already over
-        if old_state.is_game_over:
-            raise HTTPException(status_code=400, detail="Game is already over")
+        # Check the game is in a state where the die can be rolled
+        error = roll_error(old_state)
+        if error:
+            raise HTTPException(status_code=400, detail=error)
         
         # Roll the die (1-6)
         roll = random.randint(1, 6)
         
         # Create new state with the roll result
-        if roll == 1:
+        rules = old_state.rules
+        if roll == 1 and rules.free_first_turn and is_first_turn(game_metadata):
+            # Free Pig - a 1 on the first turn is a re-roll, keep the turn going
+            game_metadata.current_rolls = game_metadata.current_rolls + [roll]
+            new_state = GameState(
+                game_id=old_state.game_id,
+                current_player_index=old_state.current_player_index,
+                scores=old_state.scores.copy(),
+                turn_total=old_state.turn_total,
+                last_roll=roll,  # Explicitly set the roll value
+                ready_to_start=old_state.ready_to_start,
+                started=old_state.started,
+                rules=old_state.rules,
+                is_game_over=old_state.is_game_over,
+                winner_player_index=old_state.winner_player_index
+            )
+        elif roll == 1:
             # Player rolled a 1 - lose turn total and switch players
+            game_metadata.turn_history = game_metadata.turn_history + [TurnRecord(
+                player_index=old_state.current_player_index,
+                rolls=game_metadata.current_rolls + [roll],
+                points_banked=0,
+                pigged_out=True
+            )]
+            game_metadata.current_rolls = []
             new_state = GameState(
                 game_id=old_state.game_id,
                 current_player_index=1 - old_state.current_player_index,
@@ -185,11 +622,21 @@ class GameplayApiImpl(BaseGameplayApi):
                 turn_total=0,
                 last_roll=roll,  # Explicitly set the roll value
                 ready_to_start=old_state.ready_to_start,
+                started=old_state.started,
+                rules=old_state.rules,
                 is_game_over=old_state.is_game_over,
                 winner_player_index=old_state.winner_player_index
             )
+        elif (
+            rules.max_turn_score is not None
+            and old_state.turn_total + roll >= rules.max_turn_score
+        ):
+            # Reaching the turn score cap forces a hold, banking at most the cap
+            game_metadata.current_rolls = game_metadata.current_rolls + [roll]
+            new_state = bank_turn(game_metadata, rules.max_turn_score, last_roll=roll)
         else:
             # Add roll to turn total
+            game_metadata.current_rolls = game_metadata.current_rolls + [roll]
             new_state = GameState(
                 game_id=old_state.game_id,
                 current_player_index=old_state.current_player_index,
@@ -197,28 +644,36 @@ class GameplayApiImpl(BaseGameplayApi):
                 turn_total=old_state.turn_total + roll,
                 last_roll=roll,  # Explicitly set the roll value
                 ready_to_start=old_state.ready_to_start,
+                started=old_state.started,
+                rules=old_state.rules,
                 is_game_over=old_state.is_game_over,
                 winner_player_index=old_state.winner_player_index
             )
         
         # Update storage with new state
+        new_state.action_id = action_id
+        remember_action(game_metadata, "roll", action_id, new_state)
         game_metadata.state = new_state
+        game_metadata.last_action_at = time.monotonic()
         game_storage[game_id] = game_metadata
         
         return new_state
 
-    async def hold_turn(self, game_id: UUID) -> GameState:
+    async def hold_turn(
+        self, game_id: UUID, action_id: Optional[str] = None
+    ) -> GameState:
         """
         Current player holds, ending their turn.
         
         Game rules:
         - Add turn_total to player's score
-        - Check for winner (score >= WINNING_SCORE)
+        - Check for winner (score >= the game's winning score)
         - Switch to next player
         - Reset turn_total to 0
         
         Args:
             game_id: The unique identifier of the game
+            action_id: Optional client-generated ID used to deduplicate retries
             
         Returns:
             Updated GameState after holding
@@ -233,63 +688,23 @@ class GameplayApiImpl(BaseGameplayApi):
         game_metadata = game_storage[game_id]
         old_state = game_metadata.state
         
-        # Check if game is ready to start
-        if not old_state.ready_to_start:
-            raise HTTPException(
-                status_code=400,
-                detail="Cannot start game. Waiting for second player to join."
-            )
-        
-        # Check if game is already over
-        if old_state.is_game_over:
-            raise HTTPException(status_code=400, detail="Game is already over")
+        # A retried action returns the state it already produced
+        recent_actions = game_metadata.recent_actions
+        if action_id is not None and ("hold", action_id) in recent_actions:
+            return recent_actions[("hold", action_id)]
         
-        # Cannot hold if last roll was a 1 (turn already ended)
-        if old_state.last_roll == 1:
-            raise HTTPException(
-                status_code=400, 
-                detail="Cannot hold after rolling a 1. Turn has already ended."
-            )
+        # Check the game is in a state where the player can hold
+        error = hold_error(old_state, game_metadata.current_rolls)
+        if error:
+            raise HTTPException(status_code=400, detail=error)
         
-        # Cannot hold if turn_total is 0 (must roll at least once)
-        if old_state.turn_total == 0:
-            raise HTTPException(
-                status_code=400,
-                detail="Cannot hold with zero points. You must roll at least once."
-            )
-        
-        # Add turn total to current player's score
-        current_player = old_state.current_player_index
-        new_scores = old_state.scores.copy()
-        new_scores[current_player] += old_state.turn_total
-        
-        # Check if current player won
-        if new_scores[current_player] >= WINNING_SCORE:
-            new_state = GameState(
-                game_id=old_state.game_id,
-                current_player_index=current_player,
-                scores=new_scores,
-                turn_total=0,
-                last_roll=None,  # Reset for game over
-                ready_to_start=old_state.ready_to_start,
-                is_game_over=True,
-                winner_player_index=current_player
-            )
-        else:
-            # Switch to next player
-            new_state = GameState(
-                game_id=old_state.game_id,
-                current_player_index=1 - current_player,
-                scores=new_scores,
-                turn_total=0,
-                last_roll=None,  # Reset for next turn
-                ready_to_start=old_state.ready_to_start,
-                is_game_over=old_state.is_game_over,
-                winner_player_index=old_state.winner_player_index
-            )
+        new_state = bank_turn(game_metadata, old_state.turn_total)
         
         # Update storage with new state (FIXED: store metadata, not just state)
+        new_state.action_id = action_id
+        remember_action(game_metadata, "hold", action_id, new_state)
         game_metadata.state = new_state
+        game_metadata.last_action_at = time.monotonic()
         game_storage[game_id] = game_metadata
         
         return new_state
@@ -12,10 +12,15 @@
 """  # noqa: E501
 
 
-from fastapi import FastAPI
+from fastapi import Depends, FastAPI
 
 from openapi_server.apis.game_management_api import router as GameManagementApiRouter
 from openapi_server.apis.gameplay_api import router as GameplayApiRouter
+from openapi_server.impl.pig_game_impl import (
+    game_storage,
+    reaped_games,
+    start_for_legacy_clients,
+)
 
 app = FastAPI(
     title="Pig Game API",
@@ -23,5 +28,35 @@ app = FastAPI(
     version="1.0.0",
 )
 
-app.include_router(GameManagementApiRouter)
-app.include_router(GameplayApiRouter)
+API_PREFIX = "/api/v1"
+
+app.include_router(GameManagementApiRouter, prefix=API_PREFIX)
+app.include_router(GameplayApiRouter, prefix=API_PREFIX)
+
+# Unversioned aliases kept for clients that predate the /api/v1 prefix. Those
+# clients never call /start, so a roll or hold sent through them starts the
+# game implicitly once both players have joined, as it did before.
+app.include_router(GameManagementApiRouter, include_in_schema=False)
+app.include_router(
+    GameplayApiRouter,
+    include_in_schema=False,
+    dependencies=[Depends(start_for_legacy_clients)],
+)
+
+
+# Container probes, kept outside the versioned API
+@app.get("/api/health/live", include_in_schema=False)
+async def liveness() -> dict:
+    return {"status": "ok"}
+
+
+@app.get("/api/health/ready", include_in_schema=False)
+async def readiness() -> dict:
+    # Games are held in memory, so there are no dependencies to wait for
+    return {"status": "ready", "components": {}}
+
+
+@app.get("/api/metrics", include_in_schema=False)
+async def metrics() -> dict:
+    # Games currently stored, and totals removed by each cleanup policy
+    return {"games": len(game_storage), "reaped_games": dict(reaped_games)}
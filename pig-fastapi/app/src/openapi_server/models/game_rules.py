@@ -0,0 +1,100 @@
+# coding: utf-8
+
+"""
+    Pig Game API
+
+    API for playing the classic dice game Pig.
+
+    The version of the OpenAPI document: 1.0.0
+    Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+    Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+import pprint
+import re  # noqa: F401
+import json
+
+
+
+
+from pydantic import BaseModel, ConfigDict, Field, StrictBool
+from typing import Any, ClassVar, Dict, List, Optional
+from typing_extensions import Annotated
+try:
+    from typing import Self
+except ImportError:
+    from typing_extensions import Self
+
+class GameRules(BaseModel):
+    """
+    The rules in force for this game.
+    """ # noqa: E501
+    winning_score: Annotated[int, Field(strict=True, ge=1)] = Field(description="Score a player must reach to win.")
+    max_turn_score: Optional[Annotated[int, Field(strict=True, ge=1)]] = Field(default=None, description="Turn total at which the player is forced to hold, banking at most this many points. Null if turns are uncapped.")
+    free_first_turn: StrictBool = Field(description="Indicates if a 1 rolled on a player's very first turn is a free re-roll instead of a pig-out.")
+    __properties: ClassVar[List[str]] = ["winning_score", "max_turn_score", "free_first_turn"]
+
+    model_config = {
+        "populate_by_name": True,
+        "validate_assignment": True,
+        "protected_namespaces": (),
+    }
+
+
+    def to_str(self) -> str:
+        """Returns the string representation of the model using alias"""
+        return pprint.pformat(self.model_dump(by_alias=True))
+
+    def to_json(self) -> str:
+        """Returns the JSON representation of the model using alias"""
+        # TODO: pydantic v2: use .model_dump_json(by_alias=True, exclude_unset=True) instead
+        return json.dumps(self.to_dict())
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Self:
+        """Create an instance of GameRules from a JSON string"""
+        return cls.from_dict(json.loads(json_str))
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Return the dictionary representation of the model using alias.
+
+        This has the following differences from calling pydantic's
+        `self.model_dump(by_alias=True)`:
+
+        * `None` is only added to the output dict for nullable fields that
+          were set at model initialization. Other fields with value `None`
+          are ignored.
+        """
+        _dict = self.model_dump(
+            by_alias=True,
+            exclude={
+            },
+            exclude_none=True,
+        )
+        # set to None if max_turn_score (nullable) is None
+        # and model_fields_set contains the field
+        if self.max_turn_score is None and "max_turn_score" in self.model_fields_set:
+            _dict['max_turn_score'] = None
+
+        return _dict
+
+    @classmethod
+    def from_dict(cls, obj: Dict) -> Self:
+        """Create an instance of GameRules from a dict"""
+        if obj is None:
+            return None
+
+        if not isinstance(obj, dict):
+            return cls.model_validate(obj)
+
+        _obj = cls.model_validate({
+            "winning_score": obj.get("winning_score"),
+            "max_turn_score": obj.get("max_turn_score"),
+            "free_first_turn": obj.get("free_first_turn")
+        })
+        return _obj
+
+
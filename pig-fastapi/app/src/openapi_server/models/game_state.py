@@ -24,6 +24,8 @@ from pydantic import BaseModel, ConfigDict, Field, StrictBool
 from typing import Any, ClassVar, Dict, List, Optional
 from typing_extensions import Annotated
 from uuid import UUID
+from openapi_server.models.game_rules import GameRules
+from openapi_server.models.turn_record import TurnRecord
 try:
     from typing import Self
 except ImportError:
@@ -39,9 +41,13 @@ class GameState(BaseModel):
     turn_total: Annotated[int, Field(strict=True, ge=0)] = Field(description="Points accumulated in the current players turn.")
     last_roll: Optional[Annotated[int, Field(le=6, strict=True, ge=1)]] = Field(default=None, description="The result of the last die roll. Null if no roll yet this turn.")
     ready_to_start: StrictBool = Field(description="Indicates if both players have joined and the game can be played.")
+    started: StrictBool = Field(description="Indicates if the game has been explicitly started. Rolling and holding are rejected until it is.")
     is_game_over: StrictBool = Field(description="Indicates if the game has ended.")
     winner_player_index: Optional[Annotated[int, Field(le=1, strict=True, ge=0)]] = Field(default=None, description="Index of the winning player if the game is over. Null otherwise.")
-    __properties: ClassVar[List[str]] = ["game_id", "current_player_index", "scores", "turn_total", "last_roll", "ready_to_start", "is_game_over", "winner_player_index"]
+    turn_history: Optional[List[TurnRecord]] = Field(default=None, description="Completed turns of both players in play order. Only included when requested with include_history.")
+    action_id: Optional[Annotated[str, Field(strict=True, max_length=64)]] = Field(default=None, description="The client action ID that produced this state, if one was supplied.")
+    rules: GameRules
+    __properties: ClassVar[List[str]] = ["game_id", "current_player_index", "scores", "turn_total", "last_roll", "ready_to_start", "started", "is_game_over", "winner_player_index", "turn_history", "action_id", "rules"]
 
     model_config = {
         "populate_by_name": True,
@@ -77,14 +83,22 @@ class GameState(BaseModel):
         * OpenAPI `readOnly` fields are excluded.
         * OpenAPI `readOnly` fields are excluded.
         * OpenAPI `readOnly` fields are excluded.
+        * OpenAPI `readOnly` fields are excluded.
+        * OpenAPI `readOnly` fields are excluded.
+        * OpenAPI `readOnly` fields are excluded.
+        * OpenAPI `readOnly` fields are excluded.
         """
         _dict = self.model_dump(
             by_alias=True,
             exclude={
                 "game_id",
                 "ready_to_start",
+                "started",
                 "is_game_over",
                 "winner_player_index",
+                "turn_history",
+                "action_id",
+                "rules",
             },
             exclude_none=True,
         )
@@ -98,6 +112,16 @@ class GameState(BaseModel):
         if self.winner_player_index is None and "winner_player_index" in self.model_fields_set:
             _dict['winner_player_index'] = None
 
+        # set to None if turn_history (nullable) is None
+        # and model_fields_set contains the field
+        if self.turn_history is None and "turn_history" in self.model_fields_set:
+            _dict['turn_history'] = None
+
+        # set to None if action_id (nullable) is None
+        # and model_fields_set contains the field
+        if self.action_id is None and "action_id" in self.model_fields_set:
+            _dict['action_id'] = None
+
         return _dict
 
     @classmethod
@@ -116,8 +140,12 @@ class GameState(BaseModel):
             "turn_total": obj.get("turn_total"),
             "last_roll": obj.get("last_roll"),
             "ready_to_start": obj.get("ready_to_start"),
+            "started": obj.get("started"),
             "is_game_over": obj.get("is_game_over"),
-            "winner_player_index": obj.get("winner_player_index")
+            "winner_player_index": obj.get("winner_player_index"),
+            "turn_history": [TurnRecord.from_dict(_item) for _item in obj["turn_history"]] if obj.get("turn_history") is not None else None,
+            "action_id": obj.get("action_id"),
+            "rules": GameRules.from_dict(obj["rules"]) if obj.get("rules") is not None else None
         })
         return _obj
 
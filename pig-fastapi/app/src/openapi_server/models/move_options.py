@@ -0,0 +1,96 @@
+# coding: utf-8
+
+"""
+    Pig Game API
+
+    API for playing the classic dice game Pig.
+
+    The version of the OpenAPI document: 1.0.0
+    Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+    Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+import pprint
+import re  # noqa: F401
+import json
+
+
+
+
+from pydantic import BaseModel, ConfigDict, Field, StrictBool
+from typing import Any, ClassVar, Dict, List
+try:
+    from typing import Self
+except ImportError:
+    from typing_extensions import Self
+
+class MoveOptions(BaseModel):
+    """
+    The actions a player may take right now.
+    """ # noqa: E501
+    is_your_turn: StrictBool = Field(description="Indicates if it is this player's turn in a game that has started and is not over.")
+    can_roll: StrictBool = Field(description="Indicates if this player may roll the die now.")
+    can_hold: StrictBool = Field(description="Indicates if this player may hold now.")
+    can_start: StrictBool = Field(description="Indicates if the game can be started now (both players joined and not yet started).")
+    __properties: ClassVar[List[str]] = ["is_your_turn", "can_roll", "can_hold", "can_start"]
+
+    model_config = {
+        "populate_by_name": True,
+        "validate_assignment": True,
+        "protected_namespaces": (),
+    }
+
+
+    def to_str(self) -> str:
+        """Returns the string representation of the model using alias"""
+        return pprint.pformat(self.model_dump(by_alias=True))
+
+    def to_json(self) -> str:
+        """Returns the JSON representation of the model using alias"""
+        # TODO: pydantic v2: use .model_dump_json(by_alias=True, exclude_unset=True) instead
+        return json.dumps(self.to_dict())
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Self:
+        """Create an instance of MoveOptions from a JSON string"""
+        return cls.from_dict(json.loads(json_str))
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Return the dictionary representation of the model using alias.
+
+        This has the following differences from calling pydantic's
+        `self.model_dump(by_alias=True)`:
+
+        * `None` is only added to the output dict for nullable fields that
+          were set at model initialization. Other fields with value `None`
+          are ignored.
+        """
+        _dict = self.model_dump(
+            by_alias=True,
+            exclude={
+            },
+            exclude_none=True,
+        )
+        return _dict
+
+    @classmethod
+    def from_dict(cls, obj: Dict) -> Self:
+        """Create an instance of MoveOptions from a dict"""
+        if obj is None:
+            return None
+
+        if not isinstance(obj, dict):
+            return cls.model_validate(obj)
+
+        _obj = cls.model_validate({
+            "is_your_turn": obj.get("is_your_turn"),
+            "can_roll": obj.get("can_roll"),
+            "can_hold": obj.get("can_hold"),
+            "can_start": obj.get("can_start")
+        })
+        return _obj
+
+
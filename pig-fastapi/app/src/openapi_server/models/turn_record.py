@@ -0,0 +1,99 @@
+# coding: utf-8
+
+"""
+    Pig Game API
+
+    API for playing the classic dice game Pig.
+
+    The version of the OpenAPI document: 1.0.0
+    Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+    Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+import pprint
+import re  # noqa: F401
+import json
+
+
+
+
+from pydantic import BaseModel, ConfigDict, Field, StrictBool
+from typing import Any, ClassVar, Dict, List, Optional
+from typing_extensions import Annotated
+try:
+    from typing import Self
+except ImportError:
+    from typing_extensions import Self
+
+class TurnRecord(BaseModel):
+    """
+    A single completed turn taken by a player.
+    """ # noqa: E501
+    player_index: Annotated[int, Field(le=1, strict=True, ge=0)] = Field(description="Index of the player who took the turn (0 or 1).")
+    rolls: List[Annotated[int, Field(le=6, strict=True, ge=1)]] = Field(description="Die results rolled during the turn, in order.")
+    points_banked: Annotated[int, Field(strict=True, ge=0)] = Field(description="Points added to the player's score by holding. Zero if the player pigged out.")
+    pigged_out: StrictBool = Field(description="Indicates if the turn ended by rolling a 1.")
+    free_rerolls: Optional[Annotated[int, Field(strict=True, ge=0)]] = Field(default=0, description="Number of 1s rolled during the turn that counted as re-rolls under the free first turn rule.")
+    __properties: ClassVar[List[str]] = ["player_index", "rolls", "points_banked", "pigged_out", "free_rerolls"]
+
+    model_config = {
+        "populate_by_name": True,
+        "validate_assignment": True,
+        "protected_namespaces": (),
+    }
+
+
+    def to_str(self) -> str:
+        """Returns the string representation of the model using alias"""
+        return pprint.pformat(self.model_dump(by_alias=True))
+
+    def to_json(self) -> str:
+        """Returns the JSON representation of the model using alias"""
+        # TODO: pydantic v2: use .model_dump_json(by_alias=True, exclude_unset=True) instead
+        return json.dumps(self.to_dict())
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Self:
+        """Create an instance of TurnRecord from a JSON string"""
+        return cls.from_dict(json.loads(json_str))
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Return the dictionary representation of the model using alias.
+
+        This has the following differences from calling pydantic's
+        `self.model_dump(by_alias=True)`:
+
+        * `None` is only added to the output dict for nullable fields that
+          were set at model initialization. Other fields with value `None`
+          are ignored.
+        """
+        _dict = self.model_dump(
+            by_alias=True,
+            exclude={
+            },
+            exclude_none=True,
+        )
+        return _dict
+
+    @classmethod
+    def from_dict(cls, obj: Dict) -> Self:
+        """Create an instance of TurnRecord from a dict"""
+        if obj is None:
+            return None
+
+        if not isinstance(obj, dict):
+            return cls.model_validate(obj)
+
+        _obj = cls.model_validate({
+            "player_index": obj.get("player_index"),
+            "rolls": obj.get("rolls"),
+            "points_banked": obj.get("points_banked"),
+            "pigged_out": obj.get("pigged_out"),
+            "free_rerolls": obj.get("free_rerolls") if obj.get("free_rerolls") is not None else 0
+        })
+        return _obj
+
+
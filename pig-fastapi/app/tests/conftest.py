@@ -1,7 +1,10 @@
+from types import SimpleNamespace
+
 import pytest
 from fastapi import FastAPI
 from fastapi.testclient import TestClient
 
+from openapi_server.impl import pig_game_impl
 from openapi_server.main import app as application
 
 
@@ -15,3 +18,46 @@ def app() -> FastAPI:
 @pytest.fixture
 def client(app) -> TestClient:
     return TestClient(app)
+
+
+@pytest.fixture(autouse=True)
+def empty_game_storage():
+    pig_game_impl.game_storage.clear()
+    pig_game_impl.reaped_games.clear()
+    yield
+    pig_game_impl.game_storage.clear()
+    pig_game_impl.reaped_games.clear()
+
+
+@pytest.fixture
+def script_rolls(monkeypatch):
+    """Returns a function that makes the server roll the given results, in order."""
+    def script(*rolls):
+        results = iter(rolls)
+        monkeypatch.setattr(
+            pig_game_impl.random, "randint", lambda low, high: next(results)
+        )
+
+    return script
+
+
+@pytest.fixture
+def start_game(client):
+    """Returns a function that joins both players to a new game and starts it."""
+    def start(**options) -> str:
+        game_id = client.post("/api/v1/game", params=options).json()["game_id"]
+        client.post("/api/v1/game", params=options)
+        response = client.post("/api/v1/game/{game_id}/start".format(game_id=game_id))
+        assert response.status_code == 200
+        return game_id
+
+    return start
+
+
+@pytest.fixture
+def clock(monkeypatch):
+    """Replaces the server's monotonic clock with one the test moves forward."""
+    fake = SimpleNamespace(now=1000.0)
+    fake.monotonic = lambda: fake.now
+    monkeypatch.setattr(pig_game_impl, "time", fake)
+    return fake
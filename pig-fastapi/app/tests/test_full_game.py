@@ -0,0 +1,50 @@
+# coding: utf-8
+
+from fastapi.testclient import TestClient
+
+from openapi_server.impl import pig_game_impl
+
+
+def test_full_game(client: TestClient, script_rolls, monkeypatch):
+    """Two players join, start and play a scripted game through to a win."""
+    monkeypatch.setattr(pig_game_impl, "DEFAULT_MAX_TURN_SCORE", None)
+    monkeypatch.setattr(pig_game_impl, "DEFAULT_FREE_FIRST_TURN", False)
+
+    created = client.post("/api/v1/game")
+    joined = client.post("/api/v1/game")
+    game_id = created.json()["game_id"]
+    assert created.json()["player_id"] == 0
+    assert joined.json() == {"game_id": game_id, "player_id": 1}
+
+    game_url = "/api/v1/game/{game_id}".format(game_id=game_id)
+    assert client.post(game_url + "/roll").status_code == 400
+    assert client.post(game_url + "/start").json()["started"] is True
+
+    # Player 0 banks 48, player 1 pigs out, then player 0 rolls past 100
+    script_rolls(*([6] * 8 + [3, 1] + [6] * 9))
+    expected = (
+        [("roll", [0, 0], 6 * n, 0) for n in range(1, 9)]
+        + [("hold", [48, 0], 0, 1)]
+        + [("roll", [48, 0], 3, 1), ("roll", [48, 0], 0, 0)]
+        + [("roll", [48, 0], 6 * n, 0) for n in range(1, 10)]
+        + [("hold", [102, 0], 0, 0)]
+    )
+    for action, scores, turn_total, current_player in expected:
+        response = client.post(game_url + "/" + action)
+        assert response.status_code == 200
+        state = response.json()
+        assert state["scores"] == scores
+        assert state["turn_total"] == turn_total
+        assert state["current_player_index"] == current_player
+
+    assert state["is_game_over"] is True
+    assert state["winner_player_index"] == 0
+    assert client.post(game_url + "/roll").status_code == 400
+    assert client.post(game_url + "/hold").status_code == 400
+
+    history = client.get(game_url, params={"include_history": True}).json()
+    turns = [
+        (turn["player_index"], turn["points_banked"], turn["pigged_out"])
+        for turn in history["turn_history"]
+    ]
+    assert turns == [(0, 48, False), (1, 0, True), (0, 54, False)]
@@ -8,6 +8,28 @@ from typing_extensions import Annotated  # noqa: F401
 from uuid import UUID  # noqa: F401
 from openapi_server.models.error_response import ErrorResponse  # noqa: F401
 from openapi_server.models.game_state import GameState  # noqa: F401
+from openapi_server.models.move_options import MoveOptions  # noqa: F401
+from openapi_server.impl import pig_game_impl
+
+
+def test_get_move_options(client: TestClient):
+    """Test case for get_move_options
+
+    Get the actions a player may take right now.
+    """
+    params = [("player_id", 0)]
+    headers = {
+    }
+    # uncomment below to make a request
+    #response = client.request(
+    #    "GET",
+    #    "/game/{game_id}/options".format(game_id='game_id_example'),
+    #    headers=headers,
+    #    params=params,
+    #)
+
+    # uncomment below to assert the status code of the HTTP response
+    #assert response.status_code == 200
 
 
 def test_roll_die(client: TestClient):
@@ -15,7 +37,7 @@ def test_roll_die(client: TestClient):
 
     Roll the die for the current player.
     """
-
+    params = [("action_id", 'action_id_example')]
     headers = {
     }
     # uncomment below to make a request
@@ -23,6 +45,7 @@ def test_roll_die(client: TestClient):
     #    "POST",
     #    "/game/{game_id}/roll".format(game_id='game_id_example'),
     #    headers=headers,
+    #    params=params,
     #)
 
     # uncomment below to assert the status code of the HTTP response
@@ -34,7 +57,7 @@ def test_hold_turn(client: TestClient):
 
     Current player holds, ending their turn and adding turn total to score.
     """
-
+    params = [("action_id", 'action_id_example')]
     headers = {
     }
     # uncomment below to make a request
@@ -42,8 +65,292 @@ def test_hold_turn(client: TestClient):
     #    "POST",
     #    "/game/{game_id}/hold".format(game_id='game_id_example'),
     #    headers=headers,
+    #    params=params,
     #)
 
     # uncomment below to assert the status code of the HTTP response
     #assert response.status_code == 200
 
+
+def test_roll_die_reaching_cap_banks_turn(client: TestClient, start_game, script_rolls):
+    """Reaching the turn score cap holds automatically and passes the turn."""
+    game_id = start_game(max_turn_score=10)
+    script_rolls(4, 6)
+
+    client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+    response = client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+
+    assert response.status_code == 200
+    state = response.json()
+    assert state["scores"] == [10, 0]
+    assert state["turn_total"] == 0
+    assert state["last_roll"] == 6
+    assert state["current_player_index"] == 1
+    assert state["rules"]["max_turn_score"] == 10
+
+
+def test_roll_die_past_cap_discards_overflow(
+    client: TestClient, start_game, script_rolls
+):
+    """Points rolled beyond the turn score cap are not banked."""
+    game_id = start_game(max_turn_score=10)
+    script_rolls(6, 5)
+
+    client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+    response = client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+
+    assert response.status_code == 200
+    assert response.json()["scores"] == [10, 0]
+
+
+def test_hold_turn_allowed_below_cap(client: TestClient, start_game, script_rolls):
+    """Holding is still allowed in a capped game before the cap is reached."""
+    game_id = start_game(max_turn_score=10)
+    script_rolls(6, 3)
+
+    client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+    client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+    response = client.post("/api/v1/game/{game_id}/hold".format(game_id=game_id))
+
+    assert response.status_code == 200
+    state = response.json()
+    assert state["scores"] == [9, 0]
+    assert state["current_player_index"] == 1
+
+
+def test_roll_die_uncapped_by_default(
+    client: TestClient, start_game, script_rolls, monkeypatch
+):
+    """Without a cap the turn total keeps growing."""
+    monkeypatch.setattr(pig_game_impl, "DEFAULT_MAX_TURN_SCORE", None)
+    game_id = start_game()
+    script_rolls(6, 6)
+
+    client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+    response = client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+
+    state = response.json()
+    assert state["turn_total"] == 12
+    assert state["rules"]["max_turn_score"] is None
+
+
+def test_roll_die_first_turn_one_is_free_reroll(
+    client: TestClient, start_game, script_rolls
+):
+    """With the free first turn rule a 1 on the first turn keeps the turn going."""
+    game_id = start_game(free_first_turn=True)
+    script_rolls(5, 1)
+
+    client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+    response = client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+
+    assert response.status_code == 200
+    state = response.json()
+    assert state["last_roll"] == 1
+    assert state["turn_total"] == 5
+    assert state["current_player_index"] == 0
+    assert state["rules"]["free_first_turn"] is True
+
+    response = client.post("/api/v1/game/{game_id}/hold".format(game_id=game_id))
+    assert response.status_code == 200
+    assert response.json()["scores"] == [5, 0]
+
+    history = client.get(
+        "/api/v1/game/{game_id}".format(game_id=game_id),
+        params={"include_history": True},
+    ).json()["turn_history"]
+    assert history[0]["rolls"] == [5, 1]
+    assert history[0]["free_rerolls"] == 1
+
+
+
+def test_hold_turn_after_free_reroll_as_first_roll(
+    client: TestClient, start_game, script_rolls
+):
+    """A free re-roll as the first roll leaves the turn going with no points."""
+    game_id = start_game(free_first_turn=True)
+    script_rolls(1)
+
+    client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+    response = client.post("/api/v1/game/{game_id}/hold".format(game_id=game_id))
+
+    assert response.status_code == 400
+    assert response.json()["detail"] == (
+        "Cannot hold with zero points. You must roll at least once."
+    )
+    options = client.get(
+        "/api/v1/game/{game_id}/options".format(game_id=game_id),
+        params={"player_id": 0},
+    ).json()
+    assert options["can_roll"] is True
+    assert options["can_hold"] is False
+
+def test_roll_die_one_after_first_turn_pigs_out(
+    client: TestClient, start_game, script_rolls
+):
+    """The free first turn rule only covers each player's first turn."""
+    game_id = start_game(free_first_turn=True)
+    script_rolls(4, 3, 1)
+
+    client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+    client.post("/api/v1/game/{game_id}/hold".format(game_id=game_id))
+    client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+    client.post("/api/v1/game/{game_id}/hold".format(game_id=game_id))
+    response = client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+
+    state = response.json()
+    assert state["last_roll"] == 1
+    assert state["scores"] == [4, 3]
+    assert state["current_player_index"] == 1
+
+
+def test_roll_die_first_turn_one_pigs_out_by_default(
+    client: TestClient, start_game, script_rolls, monkeypatch
+):
+    """Without the free first turn rule a 1 on the first turn ends it."""
+    monkeypatch.setattr(pig_game_impl, "DEFAULT_FREE_FIRST_TURN", False)
+    game_id = start_game()
+    script_rolls(1)
+
+    response = client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+
+    state = response.json()
+    assert state["current_player_index"] == 1
+    assert state["rules"]["free_first_turn"] is False
+
+
+def get_move_options(client: TestClient, game_id: str, player_id: int) -> dict:
+    response = client.get(
+        "/api/v1/game/{game_id}/options".format(game_id=game_id),
+        params={"player_id": player_id},
+    )
+    assert response.status_code == 200
+    return response.json()
+
+
+def test_get_move_options_before_start(client: TestClient):
+    """Nobody has a turn until the game starts; player 0 may start once it is full."""
+    game_id = client.post("/api/v1/game").json()["game_id"]
+    assert get_move_options(client, game_id, 0) == {
+        "is_your_turn": False,
+        "can_roll": False,
+        "can_hold": False,
+        "can_start": False,
+    }
+
+    client.post("/api/v1/game")
+    for player_id in (0, 1):
+        assert get_move_options(client, game_id, player_id) == {
+            "is_your_turn": False,
+            "can_roll": False,
+            "can_hold": False,
+            "can_start": True,
+        }
+
+
+def test_get_move_options_during_turn(client: TestClient, start_game, script_rolls):
+    """Only the current player may move, and may hold once they have points."""
+    game_id = start_game(free_first_turn=False)
+    assert get_move_options(client, game_id, 0) == {
+        "is_your_turn": True,
+        "can_roll": True,
+        "can_hold": False,
+        "can_start": False,
+    }
+    assert get_move_options(client, game_id, 1)["is_your_turn"] is False
+
+    script_rolls(4)
+    client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+
+    assert get_move_options(client, game_id, 0)["can_hold"] is True
+    assert get_move_options(client, game_id, 1) == {
+        "is_your_turn": False,
+        "can_roll": False,
+        "can_hold": False,
+        "can_start": False,
+    }
+
+
+def test_get_move_options_after_game_over(
+    client: TestClient, start_game, script_rolls, monkeypatch
+):
+    """Nobody has a turn once the game is over, including the winner."""
+    monkeypatch.setattr(pig_game_impl, "DEFAULT_MAX_TURN_SCORE", None)
+    game_id = start_game()
+    script_rolls(*[6] * 17)
+    for _ in range(17):
+        client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+    state = client.post("/api/v1/game/{game_id}/hold".format(game_id=game_id)).json()
+    assert state["is_game_over"] is True
+
+    for player_id in (0, 1):
+        assert get_move_options(client, game_id, player_id) == {
+            "is_your_turn": False,
+            "can_roll": False,
+            "can_hold": False,
+            "can_start": False,
+        }
+
+
+def test_roll_die_retry_returns_same_state(
+    client: TestClient, start_game, script_rolls
+):
+    """Retrying a roll with the same action_id does not roll again."""
+    game_id = start_game(free_first_turn=False)
+    script_rolls(4)
+    roll_url = "/api/v1/game/{game_id}/roll".format(game_id=game_id)
+
+    first = client.post(roll_url, params={"action_id": "roll-1"})
+    retry = client.post(roll_url, params={"action_id": "roll-1"})
+
+    assert first.status_code == 200 and retry.status_code == 200
+    assert retry.json() == first.json()
+    assert retry.json()["turn_total"] == 4
+    assert retry.json()["action_id"] == "roll-1"
+
+
+def test_hold_turn_reusing_roll_action_id_applies(
+    client: TestClient, start_game, script_rolls
+):
+    """A hold that reuses a roll's action_id is a new action, not a retry."""
+    game_id = start_game(free_first_turn=False)
+    script_rolls(4)
+
+    client.post(
+        "/api/v1/game/{game_id}/roll".format(game_id=game_id),
+        params={"action_id": "move-1"},
+    )
+    response = client.post(
+        "/api/v1/game/{game_id}/hold".format(game_id=game_id),
+        params={"action_id": "move-1"},
+    )
+
+    assert response.status_code == 200
+    state = response.json()
+    assert state["scores"] == [4, 0]
+    assert state["current_player_index"] == 1
+
+
+def test_roll_die_forgets_oldest_action_id(
+    client: TestClient, start_game, script_rolls, monkeypatch
+):
+    """Only the last ACTION_ID_WINDOW actions are remembered."""
+    monkeypatch.setattr(pig_game_impl, "DEFAULT_MAX_TURN_SCORE", None)
+    window = pig_game_impl.ACTION_ID_WINDOW
+    game_id = start_game(free_first_turn=False)
+    script_rolls(*[2] * (window + 2))
+    roll_url = "/api/v1/game/{game_id}/roll".format(game_id=game_id)
+
+    client.post(roll_url, params={"action_id": "oldest"})
+    for n in range(window - 1):
+        client.post(roll_url, params={"action_id": "roll-{n}".format(n=n)})
+
+    # Still within the window, so this is a retry
+    response = client.post(roll_url, params={"action_id": "oldest"})
+    assert response.json()["turn_total"] == 2
+
+    client.post(roll_url, params={"action_id": "newest"})
+
+    # Evicted, so the same ID now rolls again
+    response = client.post(roll_url, params={"action_id": "oldest"})
+    assert response.json()["turn_total"] == 2 * (window + 2)
@@ -0,0 +1,19 @@
+# coding: utf-8
+
+from fastapi.testclient import TestClient
+
+
+def test_liveness(client: TestClient):
+    """The liveness probe answers while the process is up."""
+    response = client.get("/api/health/live")
+
+    assert response.status_code == 200
+    assert response.json() == {"status": "ok"}
+
+
+def test_readiness(client: TestClient):
+    """The readiness probe reports ready, with no dependencies to check."""
+    response = client.get("/api/health/ready")
+
+    assert response.status_code == 200
+    assert response.json() == {"status": "ready", "components": {}}
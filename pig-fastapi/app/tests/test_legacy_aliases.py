@@ -0,0 +1,68 @@
+# coding: utf-8
+
+from fastapi.testclient import TestClient
+
+
+def join_game(client: TestClient, prefix: str) -> str:
+    """Joins both players to a new game without starting it."""
+    game_id = client.post(prefix + "/game").json()["game_id"]
+    client.post(prefix + "/game")
+    return game_id
+
+
+def test_unversioned_roll_starts_game(client: TestClient, script_rolls):
+    """A roll sent through the unversioned aliases starts a full game."""
+    game_id = join_game(client, "")
+    script_rolls(4)
+
+    response = client.post("/game/{game_id}/roll".format(game_id=game_id))
+
+    assert response.status_code == 200
+    state = response.json()
+    assert state["started"] is True
+    assert state["turn_total"] == 4
+
+
+def test_unversioned_hold_starts_game(client: TestClient):
+    """A hold sent through the unversioned aliases starts a full game first."""
+    game_id = join_game(client, "")
+
+    response = client.post("/game/{game_id}/hold".format(game_id=game_id))
+
+    # The game is started, so the hold fails only for lack of points
+    assert response.status_code == 400
+    assert "zero points" in response.json()["detail"]
+    state = client.get("/api/v1/game/{game_id}".format(game_id=game_id)).json()
+    assert state["started"] is True
+
+
+def test_unversioned_reads_do_not_start_game(client: TestClient):
+    """Reading state or move options through the aliases leaves the game unstarted."""
+    game_id = join_game(client, "/api/v1")
+
+    client.get("/game/{game_id}".format(game_id=game_id))
+    client.get(
+        "/game/{game_id}/options".format(game_id=game_id), params={"player_id": 0}
+    )
+
+    state = client.get("/api/v1/game/{game_id}".format(game_id=game_id)).json()
+    assert state["started"] is False
+
+
+def test_unversioned_start_game(client: TestClient):
+    """POST /game/{game_id}/start still works through the aliases."""
+    game_id = join_game(client, "")
+
+    response = client.post("/game/{game_id}/start".format(game_id=game_id))
+
+    assert response.status_code == 200
+    assert response.json()["started"] is True
+
+
+def test_versioned_roll_requires_start(client: TestClient):
+    """Clients on /api/v1 must still start the game explicitly."""
+    game_id = join_game(client, "/api/v1")
+
+    response = client.post("/api/v1/game/{game_id}/roll".format(game_id=game_id))
+
+    assert response.status_code == 400
@@ -0,0 +1,26 @@
+# coding: utf-8
+
+import pytest
+
+from openapi_server.impl.pig_game_impl import env_int
+
+
+def test_env_int_unset_uses_default(monkeypatch):
+    monkeypatch.delenv("PIG_MAX_TURN_SCORE", raising=False)
+
+    assert env_int("PIG_MAX_TURN_SCORE", None, 1) is None
+
+
+def test_env_int_reads_value(monkeypatch):
+    monkeypatch.setenv("PIG_MAX_TURN_SCORE", "20")
+
+    assert env_int("PIG_MAX_TURN_SCORE", None, 1) == 20
+
+
+@pytest.mark.parametrize("value", ["abc", "2.5", "0", "-3"])
+def test_env_int_rejects_invalid_value(monkeypatch, value):
+    """Invalid settings fail at startup with a message naming the variable."""
+    monkeypatch.setenv("PIG_MAX_TURN_SCORE", value)
+
+    with pytest.raises(RuntimeError, match="PIG_MAX_TURN_SCORE"):
+        env_int("PIG_MAX_TURN_SCORE", None, 1)
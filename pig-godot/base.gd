@@ -7,7 +7,7 @@ const address = "172.20.10.13"
 
 func _on_join_game_pressed() -> void:
 	$HTTPRequest.request_completed.connect(_on_request_completed)
-	$HTTPRequest.request("http://%s:7799/game"%[address],[],HTTPClient.METHOD_POST)
+	$HTTPRequest.request("http://%s:7799/api/v1/game"%[address],[],HTTPClient.METHOD_POST)
 func _on_request_completed(result, response_code, headers, body):
 	if response_code == 200:
 		var json = JSON.parse_string(body.get_string_from_utf8())
@@ -5,6 +5,7 @@ extends Node2D
 var gameOver = false
 var yourTurn = false
 var readyToStart = false
+var started = false
 
 @export var DiceOne : Sprite2D
 
@@ -12,12 +13,30 @@ var readyToStart = false
 
 const address = "172.20.10.13"
 
+# A roll or hold that fails to get a response is resent with the same action ID,
+# so the server does not apply it twice if the first attempt did reach it
+const MAX_ACTION_RETRIES = 3
+var action_id = ""
+var action_retries = 0
+
+func _send_action(action : String, retry : bool) -> void:
+	if retry:
+		action_retries += 1
+	else:
+		action_id = "%d-%d" % [Time.get_ticks_usec(), randi()]
+		action_retries = 0
+	$HTTPRequest2.request("http://%s:7799/api/v1/game/%s/%s?action_id=%s"%[address,game_uuid,action,action_id],[],HTTPClient.METHOD_POST)
+
 func _on_roll_btn_pressed() -> void:
 	$HTTPRequest2.request_completed.disconnect(_on_request_completed_hold)
 	$HTTPRequest2.request_completed.connect(_on_request_completed_roll)
-	$HTTPRequest2.request("http://%s:7799/game/%s/roll"%[address,game_uuid],[],HTTPClient.METHOD_POST)
+	_send_action("roll", false)
 func _on_request_completed_roll(result, response_code, headers, body):
 	print(response_code)
+	if result != HTTPRequest.RESULT_SUCCESS:
+		if action_retries < MAX_ACTION_RETRIES:
+			_send_action("roll", true)
+		return
 	var json = JSON.parse_string(body.get_string_from_utf8())
 	print(json)
 	var face = Vector2i( (int(json["last_roll"]) -1)%3 , (int(json["last_roll"]) -1)/3 )
@@ -26,13 +45,18 @@ func _on_request_completed_roll(result, response_code, headers, body):
 func _on_hold_btn_pressed() -> void:
 	$HTTPRequest2.request_completed.disconnect(_on_request_completed_roll)
 	$HTTPRequest2.request_completed.connect(_on_request_completed_hold)
-	$HTTPRequest2.request("http://%s:7799/game/%s/hold"%[address,game_uuid],[],HTTPClient.METHOD_POST)
+	_send_action("hold", false)
 func _on_request_completed_hold(result, response_code, headers, body):
-	pass
+	if result != HTTPRequest.RESULT_SUCCESS and action_retries < MAX_ACTION_RETRIES:
+		_send_action("hold", true)
+
+# The new state is picked up by the next poll, so the response is not handled here
+func _on_start_btn_pressed() -> void:
+	$HTTPRequest3.request("http://%s:7799/api/v1/game/%s/start"%[address,game_uuid],[],HTTPClient.METHOD_POST)
 
 func _on_timer_timeout() -> void:
 	$HTTPRequest.request_completed.connect(_on_request_completed_label)
-	$HTTPRequest.request("http://%s:7799/game/%s"%[address,game_uuid],[],HTTPClient.METHOD_GET)
+	$HTTPRequest.request("http://%s:7799/api/v1/game/%s"%[address,game_uuid],[],HTTPClient.METHOD_GET)
 func _on_request_completed_label(result, response_code, headers, body):
 	print(response_code)
 	if response_code == 200:
@@ -43,17 +67,31 @@ func _on_request_completed_label(result, response_code, headers, body):
 Your Score: %d
 Opponent Score: %d
 Goal: %d
-Other Player Joined: %s''' % [json["game_id"],'Your' if int(json["current_player_index"]) == player_index else 'Their',json["scores"][player_index],json["scores"][abs(player_index-1)],100,str(readyToStart)]
+Turn Cap: %s
+Free First Turn: %s
+Other Player Joined: %s
+Game Started: %s''' % [json["game_id"],'Your' if int(json["current_player_index"]) == player_index else 'Their',json["scores"][player_index],json["scores"][abs(player_index-1)],int(json["rules"]["winning_score"]),'None' if typeof(json["rules"]["max_turn_score"])==TYPE_NIL else str(int(json["rules"]["max_turn_score"])),str(json["rules"]["free_first_turn"]),str(readyToStart),str(started)]
 		print("Updating Label")
 		readyToStart = json["ready_to_start"]
+		started = json["started"]
 		gameOver = json["is_game_over"]
 		yourTurn = (int(json["current_player_index"]) == player_index)
 		# Hide buttons if its not your turn, bad security practice, but we will trust the frontend to not play for the other player when its not their turn
-		$"Container/Hold Btn".visible = yourTurn and not gameOver and readyToStart
-		$"Container/Roll Btn".visible = yourTurn and not gameOver and readyToStart
+		$"Container/Hold Btn".visible = yourTurn and not gameOver and started
+		$"Container/Roll Btn".visible = yourTurn and not gameOver and started
+		# The player who created the game starts it once the opponent has joined
+		$"Container/Start Btn".visible = player_index == 0 and readyToStart and not started
 		
 		# Display the Dice the current player is rolling
 		if not typeof(json["last_roll"])==TYPE_NIL:
 			var face = Vector2i( (int(json["last_roll"]) -1)%3 , (int(json["last_roll"]) -1)/3 )
 			DiceOne.frame_coords = face
+	elif response_code == 404:
+		# The server removes finished games after a while, and also lobbies nobody
+		# joined and games with no moves for too long, so stop polling
+		TextLabel.text = "This game has ended or was closed for inactivity."
+		$"Container/Hold Btn".visible = false
+		$"Container/Roll Btn".visible = false
+		$"Container/Start Btn".visible = false
+		return
 	$Timer.start()